@@ -25,12 +25,13 @@ build_kb() {
 
 #
 # This function scaffolds test projects given a project name and
-# project-version.
+# project-version, optionally passing extra flags to init.
 #
 scaffold_test_project() {
     local project=$1
     local version=$2
     local plugin=${3:-""}
+    local init_flags=${4:-""}
 
     testdata_dir=$(pwd)/testdata
     mkdir -p ./testdata/$project
@@ -56,15 +57,27 @@ scaffold_test_project() {
         go mod init sigs.k8s.io/kubebuilder/testdata/$project  # our repo autodetection will traverse up to the kb module if we don't do this
 
         header_text "initializing $project ..."
-        $kb init $plugin_flag --project-version $version --domain testproject.org --license apache2 --owner "The Kubernetes authors"
+        $kb init $plugin_flag --project-version $version --domain testproject.org --license apache2 --owner "The Kubernetes authors" $init_flags
 
-        if [ $project == "project-v2" ] || [ $project == "project-v3" ]; then
+        if [ $project == "project-v2" ]; then
             header_text 'Creating APIs ...'
             $kb create api --group crew --version v1 --kind Captain --controller=true --resource=true --make=false
             $kb create webhook --group crew --version v1 --kind Captain --defaulting --programmatic-validation
             $kb create api --group crew --version v1 --kind FirstMate --controller=true --resource=true --make=false
             $kb create webhook --group crew --version v1 --kind FirstMate --conversion
             $kb create api --group crew --version v1 --kind Admiral --controller=true --resource=true --namespaced=false --make=false
+        elif [ $project == "project-v3" ]; then
+            header_text 'Creating APIs ...'
+            $kb create api --group crew --version v1 --kind Captain --controller=true --resource=true --make=false --owns apps/v1/Deployment --owns core/v1/ConfigMap
+            $kb create webhook --group crew --version v1 --kind Captain --defaulting --programmatic-validation
+            $kb create api --group crew --version v1 --kind FirstMate --controller=true --resource=true --make=false --shortname fm,mate --categories crew
+            $kb create webhook --group crew --version v1 --kind FirstMate --conversion
+            $kb create api --group crew --version v1 --kind Admiral --controller=true --resource=true --namespaced=false --make=false --finalizer
+            $kb create api --group crew --version v1 --kind Chassis --controller=true --resource=true --make=false --plural chassises
+            $kb create webhook --group crew --version v1 --kind Chassis --defaulting
+            $kb create api --group core --version v1 --kind Pod --controller=true --resource=false --make=false
+            $kb create api --group apiextensions --version v1 --kind CustomResourceDefinition --controller=true --resource=false --make=false \
+                --external-api-path k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1 --external-api-domain k8s.io
         elif [ $project == "project-v2-multigroup" ] || [ $project == "project-v3-multigroup" ]; then
             header_text 'Switching to multigroup layout ...'
             $kb edit --multigroup=true
@@ -86,6 +99,12 @@ scaffold_test_project() {
             $kb create api --group crew --version v1 --kind Captain --controller=true --resource=true --pattern=addon
             $kb create api --group crew --version v1 --kind FirstMate --controller=true --resource=true --make=false --pattern=addon
             $kb create api --group crew --version v1 --kind Admiral --controller=true --resource=true --namespaced=false --make=false --pattern=addon
+        elif [ $project == "project-v3-crd-v1beta1" ]; then
+            header_text 'Creating APIs ...'
+            $kb create api --group crew --version v1 --kind Captain --controller=true --resource=true --make=false
+            $kb create webhook --group crew --version v1 --kind Captain --defaulting --programmatic-validation
+            $kb create api --group crew --version v1 --kind FirstMate --controller=true --resource=true --make=false
+            $kb create webhook --group crew --version v1 --kind FirstMate --conversion
         fi
     fi
     make all test
@@ -104,3 +123,4 @@ scaffold_test_project project-v2-addon 2
 scaffold_test_project project-v3 3-alpha go/v3-alpha
 scaffold_test_project project-v3-multigroup 3-alpha go/v3-alpha
 scaffold_test_project project-v3-addon 3-alpha go/v3-alpha
+scaffold_test_project project-v3-crd-v1beta1 3-alpha go/v3-alpha "--crd-version v1beta1"
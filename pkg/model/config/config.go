@@ -93,6 +93,17 @@ func (c Config) HasResource(target GVK) bool {
 	return false
 }
 
+// GetResource returns the tracked resource matching the target group-version-kind, if any
+func (c Config) GetResource(target GVK) (GVK, bool) {
+	for _, r := range c.Resources {
+		if r.isEqualTo(target) {
+			return r, true
+		}
+	}
+
+	return GVK{}, false
+}
+
 // AddResource appends the provided resource to the tracked ones
 // It returns if the configuration was modified
 // NOTE: in v1 resources are not tracked, so we return false
@@ -130,6 +141,9 @@ type GVK struct {
 	Group   string `json:"group,omitempty"`
 	Version string `json:"version,omitempty"`
 	Kind    string `json:"kind,omitempty"`
+
+	// Plural is only tracked when it differs from the default pluralization of the Kind
+	Plural string `json:"plural,omitempty"`
 }
 
 // isEqualTo compares it with another resource
@@ -150,4 +150,15 @@ var _ = Describe("Config", func() {
 		Expect(config.DecodePluginConfig(key, &pluginConfig)).To(Succeed())
 		Expect(pluginConfig).To(Equal(expectedPluginConfig))
 	})
+	It("should get tracked resources regardless of their plural", func() {
+		config := Config{Version: Version3Alpha}
+		config.AddResource(GVK{Group: "crew", Version: "v1", Kind: "Chassis", Plural: "chassises"})
+
+		tracked, found := config.GetResource(GVK{Group: "crew", Version: "v1", Kind: "Chassis"})
+		Expect(found).To(BeTrue())
+		Expect(tracked.Plural).To(Equal("chassises"))
+
+		_, found = config.GetResource(GVK{Group: "crew", Version: "v1", Kind: "Captain"})
+		Expect(found).To(BeFalse())
+	})
 })
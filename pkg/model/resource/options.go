@@ -83,6 +83,14 @@ type Options struct {
 
 	// Namespaced is true if the resource is namespaced.
 	Namespaced bool
+
+	// ShortNames are the API Kind short names (e.g. 'fr' for 'kubectl get fr').
+	// Optional
+	ShortNames []string
+
+	// Categories are the groups of resources the API Kind belongs to (e.g. 'all').
+	// Optional
+	Categories []string
 }
 
 // Validate verifies that all the fields have valid values
@@ -134,7 +142,24 @@ func (opts *Options) Validate() error {
 		return fmt.Errorf("invalid Kind: %#v", validationErrors)
 	}
 
-	// TODO: validate plural strings if provided
+	// Check that short names and categories are valid DNS1035 labels, as the API server requires
+	for _, shortName := range opts.ShortNames {
+		if errs := validation.IsDNS1035Label(shortName); len(errs) != 0 {
+			return fmt.Errorf("invalid short name %q: %#v", shortName, errs)
+		}
+	}
+	for _, category := range opts.Categories {
+		if errs := validation.IsDNS1035Label(category); len(errs) != 0 {
+			return fmt.Errorf("invalid category %q: %#v", category, errs)
+		}
+	}
+
+	// Check that the plural, if provided, is a valid DNS1035 label, as the API server requires
+	if opts.Plural != "" {
+		if errs := validation.IsDNS1035Label(opts.Plural); len(errs) != 0 {
+			return fmt.Errorf("invalid plural %q: %#v", opts.Plural, errs)
+		}
+	}
 
 	return nil
 }
@@ -148,6 +173,12 @@ func (opts *Options) GVK() config.GVK {
 	}
 }
 
+// IsCoreGroup returns true if the group is a well-known core group, whose types are defined in k8s.io/api
+func (opts *Options) IsCoreGroup() bool {
+	_, found := coreGroups[opts.Group]
+	return found
+}
+
 // safeImport returns a cleaned version of the provided string that can be used for imports
 func (opts *Options) safeImport(unsafe string) string {
 	safe := unsafe
@@ -163,6 +194,13 @@ func (opts *Options) safeImport(unsafe string) string {
 func (opts *Options) NewResource(c *config.Config, doResource bool) *Resource {
 	res := opts.newResource()
 
+	// Resources tracked with a custom plural keep it unless a different one is provided
+	if opts.Plural == "" {
+		if tracked, found := c.GetResource(opts.GVK()); found && tracked.Plural != "" {
+			res.Plural = tracked.Plural
+		}
+	}
+
 	replacer := res.Replacer()
 
 	pkg := replacer.Replace(path.Join(c.Repo, "api", "%[version]"))
@@ -179,9 +217,9 @@ func (opts *Options) NewResource(c *config.Config, doResource bool) *Resource {
 	// TODO: need to support '--resource-pkg-path' flag for specifying resourcePath
 	if !doResource {
 		if !c.HasResource(opts.GVK()) {
-			if coreDomain, found := coreGroups[opts.Group]; found {
+			if opts.IsCoreGroup() {
 				pkg = replacer.Replace(path.Join("k8s.io", "api", "%[group]", "%[version]"))
-				domain = coreDomain
+				domain = coreGroups[opts.Group]
 			}
 		}
 	}
@@ -199,7 +237,7 @@ func (opts *Options) newResource() *Resource {
 	// If not provided, compute a plural for for Kind
 	plural := opts.Plural
 	if plural == "" {
-		plural = flect.Pluralize(strings.ToLower(opts.Kind))
+		plural = defaultPlural(opts.Kind)
 	}
 
 	return &Resource{
@@ -210,5 +248,12 @@ func (opts *Options) newResource() *Resource {
 		Kind:             opts.Kind,
 		Plural:           plural,
 		ImportAlias:      opts.safeImport(opts.Group + opts.Version),
+		ShortNames:       opts.ShortNames,
+		Categories:       opts.Categories,
 	}
 }
+
+// defaultPlural returns the plural computed for the provided Kind if none is provided
+func defaultPlural(kind string) string {
+	return flect.Pluralize(strings.ToLower(kind))
+}
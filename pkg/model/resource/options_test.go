@@ -109,5 +109,62 @@ var _ = Describe("Resource Options", func() {
 			err := options.Validate()
 			Expect(err).To(MatchError(ContainSubstring("kind must start with an uppercase character")))
 		})
+
+		DescribeTable("valid Plural values",
+			func(plural string) {
+				options := &Options{Group: "crew", Version: "v1", Kind: "Chassis", Plural: plural}
+				Expect(options.Validate()).To(Succeed())
+			},
+			Entry("should pass validation if Plural is lowercase", "chassis"),
+			Entry("should pass validation if Plural contains hyphens", "chassis-list"),
+		)
+
+		DescribeTable("invalid Plural values",
+			func(plural string) {
+				options := &Options{Group: "crew", Version: "v1", Kind: "Chassis", Plural: plural}
+				Expect(options.Validate()).To(MatchError(ContainSubstring("invalid plural")))
+			},
+			Entry("should fail validation if Plural is not lowercase", "Chassis"),
+			Entry("should fail validation if Plural contains dots", "chassis.list"),
+			Entry("should fail validation if Plural starts with a number", "0chassis"),
+			Entry("should fail validation if Plural is too long", strings.Repeat("a", 64)),
+		)
+
+		It("should succeed if the ShortNames and Categories are valid", func() {
+			options := &Options{Group: "crew", Version: "v1", Kind: "FirstMate",
+				ShortNames: []string{"fm", "mate"}, Categories: []string{"all", "crew"}}
+			Expect(options.Validate()).To(Succeed())
+		})
+
+		DescribeTable("invalid ShortNames",
+			func(shortName string) {
+				options := &Options{Group: "crew", Version: "v1", Kind: "FirstMate", ShortNames: []string{shortName}}
+				Expect(options.Validate()).To(MatchError(ContainSubstring("invalid short name")))
+			},
+			Entry("should fail validation if a short name is not lowercase", "FM"),
+			Entry("should fail validation if a short name contains dots", "f.m"),
+			Entry("should fail validation if a short name is empty", ""),
+		)
+
+		DescribeTable("invalid Categories",
+			func(category string) {
+				options := &Options{Group: "crew", Version: "v1", Kind: "FirstMate", Categories: []string{category}}
+				Expect(options.Validate()).To(MatchError(ContainSubstring("invalid category")))
+			},
+			Entry("should fail validation if a category is not lowercase", "All"),
+			Entry("should fail validation if a category contains whitespaces", "my crew"),
+		)
+
+		DescribeTable("core groups",
+			func(group string, isCore bool) {
+				options := &Options{Group: group, Version: "v1", Kind: "FirstMate"}
+				Expect(options.IsCoreGroup()).To(Equal(isCore))
+			},
+			Entry("should recognize the core group", "core", true),
+			Entry("should recognize the apps group", "apps", true),
+			Entry("should recognize the rbac.authorization group", "rbac.authorization", true),
+			Entry("should not recognize a project group", "crew", false),
+			Entry("should not recognize a third party group", "cert-manager", false),
+		)
 	})
 })
@@ -51,15 +51,26 @@ type Resource struct {
 
 	// Namespaced is true if the resource is namespaced.
 	Namespaced bool `json:"namespaced,omitempty"`
+
+	// ShortNames are the API Kind short names.
+	ShortNames []string `json:"shortNames,omitempty"`
+
+	// Categories are the groups of resources the API Kind belongs to.
+	Categories []string `json:"categories,omitempty"`
 }
 
-// GVK returns the group-version-kind information to check against tracked resources in the configuration file
+// GVK returns the group-version-kind information to check against tracked resources in the configuration file,
+// along with the plural if it differs from the default one so that it is tracked too
 func (r *Resource) GVK() config.GVK {
-	return config.GVK{
+	gvk := config.GVK{
 		Group:   r.Group,
 		Version: r.Version,
 		Kind:    r.Kind,
 	}
+	if r.Plural != defaultPlural(r.Kind) {
+		gvk.Plural = r.Plural
+	}
+	return gvk
 }
 
 func wrapKey(key string) string {
@@ -20,6 +20,7 @@ import (
 	"path"
 
 	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 
 	"sigs.k8s.io/kubebuilder/pkg/model/config"
@@ -107,6 +108,23 @@ var _ = Describe("Resource", func() {
 			Expect(resource.Plural).To(Equal("helmswomen"))
 		})
 
+		DescribeTable("default Plural of tricky Kinds",
+			func(kind, plural string) {
+				options := &Options{Group: "crew", Version: "v1", Kind: kind}
+				Expect(options.Validate()).To(Succeed())
+
+				resource := options.NewResource(&config.Config{Version: config.Version2}, true)
+				Expect(resource.Plural).To(Equal(plural))
+			},
+			Entry("should pluralize Chassis as chassis", "Chassis", "chassis"),
+			Entry("should pluralize Policy as policies", "Policy", "policies"),
+			Entry("should pluralize Proxy as proxies", "Proxy", "proxies"),
+			Entry("should pluralize Ingress as ingresses", "Ingress", "ingresses"),
+			Entry("should pluralize Gateway as gateways", "Gateway", "gateways"),
+			Entry("should pluralize Person as people", "Person", "people"),
+			Entry("should pluralize Analysis as analyses", "Analysis", "analyses"),
+		)
+
 		It("should keep the Plural if specified", func() {
 			options := &Options{Group: "crew", Version: "v1", Kind: "FirstMate", Plural: "mates"}
 			Expect(options.Validate()).To(Succeed())
@@ -129,6 +147,27 @@ var _ = Describe("Resource", func() {
 			Expect(resource.Plural).To(Equal("mates"))
 		})
 
+		It("should keep the Plural tracked in the configuration", func() {
+			c := &config.Config{Version: config.Version3Alpha}
+			c.AddResource(config.GVK{Group: "crew", Version: "v1", Kind: "Chassis", Plural: "chassises"})
+
+			options := &Options{Group: "crew", Version: "v1", Kind: "Chassis"}
+			Expect(options.NewResource(c, false).Plural).To(Equal("chassises"))
+
+			options.Plural = "frames"
+			Expect(options.NewResource(c, false).Plural).To(Equal("frames"))
+		})
+
+		It("should only track the Plural if it differs from the default one", func() {
+			c := &config.Config{Version: config.Version3Alpha}
+
+			options := &Options{Group: "crew", Version: "v1", Kind: "Chassis"}
+			Expect(options.NewResource(c, true).GVK().Plural).To(BeEmpty())
+
+			options.Plural = "chassises"
+			Expect(options.NewResource(c, true).GVK().Plural).To(Equal("chassises"))
+		})
+
 		It("should allow hyphens and dots in group names", func() {
 			singleGroupConfig := &config.Config{
 				Version: config.Version2,
@@ -177,6 +216,21 @@ var _ = Describe("Resource", func() {
 			Expect(resource.Domain).To(Equal("my.project.test.io"))
 		})
 
+		It("should keep the ShortNames and Categories if specified", func() {
+			options := &Options{Group: "crew", Version: "v1", Kind: "FirstMate",
+				ShortNames: []string{"fm"}, Categories: []string{"all"}}
+			Expect(options.Validate()).To(Succeed())
+
+			resource := options.NewResource(
+				&config.Config{
+					Version: config.Version2,
+				},
+				true,
+			)
+			Expect(resource.ShortNames).To(Equal(options.ShortNames))
+			Expect(resource.Categories).To(Equal(options.Categories))
+		})
+
 		It("should not append '.' if provided an empty domain", func() {
 			options := &Options{Group: "crew", Version: "v1", Kind: "FirstMate"}
 			Expect(options.Validate()).To(Succeed())
@@ -312,27 +312,64 @@ func getValidCodeFragments(i file.Inserter) file.CodeFragmentsMap {
 	return codeFragments
 }
 
-// filterExistingValues removes the single-line values that already exists
-// TODO: Add support for multi-line duplicate values
+// filterExistingValues removes the code fragments that already exist, ignoring whitespace differences
+// and empty lines so that multi-line code fragments are detected after being formatted
 func filterExistingValues(content string, codeFragmentsMap file.CodeFragmentsMap) error {
-	scanner := bufio.NewScanner(strings.NewReader(content))
-	for scanner.Scan() {
-		line := scanner.Text()
-		for marker, codeFragments := range codeFragmentsMap {
-			for i, codeFragment := range codeFragments {
-				if strings.TrimSpace(line) == strings.TrimSpace(codeFragment) {
-					codeFragmentsMap[marker] = append(codeFragments[:i], codeFragments[i+1:]...)
-				}
+	lines, err := normalizedLines(content)
+	if err != nil {
+		return err
+	}
+
+	for marker, codeFragments := range codeFragmentsMap {
+		filtered := make([]string, 0, len(codeFragments))
+		for _, codeFragment := range codeFragments {
+			codeFragmentLines, err := normalizedLines(codeFragment)
+			if err != nil {
+				return err
 			}
-			if len(codeFragmentsMap[marker]) == 0 {
-				delete(codeFragmentsMap, marker)
+			if !containsLines(lines, codeFragmentLines) {
+				filtered = append(filtered, codeFragment)
 			}
 		}
+		if len(filtered) == 0 {
+			delete(codeFragmentsMap, marker)
+		} else {
+			codeFragmentsMap[marker] = filtered
+		}
+	}
+	return nil
+}
+
+// normalizedLines returns the non-empty lines of content with their whitespace collapsed
+func normalizedLines(content string) ([]string, error) {
+	lines := make([]string, 0)
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	for scanner.Scan() {
+		if line := strings.Join(strings.Fields(scanner.Text()), " "); line != "" {
+			lines = append(lines, line)
+		}
 	}
 	if err := scanner.Err(); err != nil {
-		return err
+		return nil, err
 	}
-	return nil
+	return lines, nil
+}
+
+// containsLines checks if lines contains the consecutive sequence of lines in sub
+func containsLines(lines, sub []string) bool {
+	for i := 0; i+len(sub) <= len(lines); i++ {
+		found := true
+		for j := range sub {
+			if lines[i+j] != sub[j] {
+				found = false
+				break
+			}
+		}
+		if found {
+			return true
+		}
+	}
+	return false
 }
 
 func insertStrings(content string, codeFragmentsMap file.CodeFragmentsMap) ([]byte, error) {
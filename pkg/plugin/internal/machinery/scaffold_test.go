@@ -316,6 +316,68 @@ var _ = Describe("Scaffold", func() {
 					},
 				},
 			),
+			Entry("should filter already existing multi-line code fragments",
+				`
+if err != nil {
+	return  err
+}
+
+// +kubebuilder:scaffold:-
+`,
+				`
+if err != nil {
+	return  err
+}
+
+1
+2
+// +kubebuilder:scaffold:-
+`,
+				fakeInserter{
+					codeFragments: file.CodeFragmentsMap{
+						file.NewMarkerFor("file.go", "-"): {"if err != nil {\n  return err\n}\n", "1\n2\n"},
+					},
+				},
+			),
+			Entry("should keep hand edits and existing code fragments when scaffolding a second kind",
+				`
+setupLog.Info("hand written setup")
+if err = (&CaptainReconciler{
+		Client: mgr.GetClient(),
+	}).SetupWithManager(mgr); err != nil {
+
+	return err
+}
+// hand written comment
+// +kubebuilder:scaffold:-
+`,
+				`
+setupLog.Info("hand written setup")
+if err = (&CaptainReconciler{
+		Client: mgr.GetClient(),
+	}).SetupWithManager(mgr); err != nil {
+
+	return err
+}
+// hand written comment
+if err = (&FirstMateReconciler{
+	Client: mgr.GetClient(),
+}).SetupWithManager(mgr); err != nil {
+	return err
+}
+// +kubebuilder:scaffold:-
+`,
+				fakeInserter{
+					codeFragments: file.CodeFragmentsMap{
+						file.NewMarkerFor("file.go", "-"): {
+							"if err = (&CaptainReconciler{\n\tClient: mgr.GetClient(),\n}).SetupWithManager(mgr); err != nil {\n" +
+								"\treturn err\n}\n",
+							"if err = (&FirstMateReconciler{\n\tClient: mgr.GetClient(),\n}).SetupWithManager(mgr); err != nil {\n" +
+								"\treturn err\n}\n",
+						},
+					},
+				},
+			),
 			Entry("should not insert anything if no code fragment",
 				"", // input is provided through a template as mock fs doesn't copy it to the output buffer if no-op
 				`
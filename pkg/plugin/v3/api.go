@@ -57,11 +57,27 @@ type createAPIPlugin struct {
 	doResource     bool
 	doController   bool
 
+	// owns are the group/version/kind of the secondary resources owned by the controller
+	owns []string
+	// ownedResources are the parsed options of the resources in owns
+	ownedResources []*resource.Options
+
+	// finalizer indicates whether to scaffold the finalizer handling in the controller
+	finalizer bool
+
+	// externalAPIPath is the import path of the package that defines an external resource type
+	externalAPIPath string
+	// externalAPIDomain is the domain of an external resource type
+	externalAPIDomain string
+
 	// force indicates that the resource should be created even if it already exists
 	force bool
 
 	// runMake indicates whether to run make or not after scaffolding APIs
 	runMake bool
+
+	// crdVersion is the apiextensions.k8s.io version of the project CRDs, loaded from the PROJECT file
+	crdVersion string
 }
 
 var (
@@ -81,6 +97,9 @@ After the scaffold is written, api will run make on the project.
 	ctx.Examples = fmt.Sprintf(`  # Create a frigates API with Group: ship, Version: v1beta1 and Kind: Frigate
   %s create api --group ship --version v1beta1 --kind Frigate
 
+  # Create only a controller for the core Pod type, which is not defined in the project
+  %s create api --group core --version v1 --kind Pod --resource=false --controller=true
+
   # Edit the API Scheme
   nano api/v1beta1/frigate_types.go
 
@@ -96,7 +115,7 @@ After the scaffold is written, api will run make on the project.
   # Regenerate code and run against the Kubernetes cluster configured by ~/.kube/config
   make run
 	`,
-		ctx.CommandName)
+		ctx.CommandName, ctx.CommandName)
 }
 
 func (p *createAPIPlugin) BindFlags(fs *pflag.FlagSet) {
@@ -116,12 +135,30 @@ func (p *createAPIPlugin) BindFlags(fs *pflag.FlagSet) {
 	}
 
 	fs.BoolVar(&p.force, "force", false,
-		"attempt to create resource even if it already exists")
+		"attempt to create resource even if it already exists, overwriting the scaffolded API and controller files")
 	p.resource = &resource.Options{}
 	fs.StringVar(&p.resource.Kind, "kind", "", "resource Kind")
 	fs.StringVar(&p.resource.Group, "group", "", "resource Group")
 	fs.StringVar(&p.resource.Version, "version", "", "resource Version")
 	fs.BoolVar(&p.resource.Namespaced, "namespaced", true, "resource is namespaced")
+	fs.StringVar(&p.resource.Plural, "plural", "",
+		"resource plural form, only needed if the Kind pluralizes irregularly (defaults to the pluralized Kind)")
+	fs.StringSliceVar(&p.resource.ShortNames, "shortname", nil,
+		"resource short names, usable with kubectl instead of the plural (e.g. --shortname=fr,frig)")
+	fs.StringSliceVar(&p.resource.Categories, "categories", nil,
+		"resource categories, which group resources for kubectl (e.g. --categories=all)")
+
+	fs.StringVar(&p.externalAPIPath, "external-api-path", "",
+		"import path of the package that defines the resource, used to scaffold a controller for a type "+
+			"that is not part of the project (requires --resource=false)")
+	fs.StringVar(&p.externalAPIDomain, "external-api-domain", "",
+		"domain of the resource defined in --external-api-path, defaults to the project domain")
+
+	fs.StringSliceVar(&p.owns, "owns", nil,
+		"group/version/kind of a resource owned by the controller, may be repeated (e.g. --owns=apps/v1/Deployment), "+
+			"the plural defaults to the pluralized Kind and can be appended as /plural if it pluralizes irregularly")
+	fs.BoolVar(&p.finalizer, "finalizer", false,
+		"if set, scaffold a finalizer that lets the controller clean up before the resource is deleted")
 }
 
 func (p *createAPIPlugin) InjectConfig(c *config.Config) {
@@ -137,6 +174,17 @@ func (p *createAPIPlugin) Validate() error {
 		return err
 	}
 
+	// Load the CRD version the project was initialized with
+	cfg := pluginConfig{}
+	if err := p.config.DecodePluginConfig(plugin.KeyFor(Plugin{}), &cfg); err != nil {
+		return err
+	}
+	p.crdVersion = cfg.CRDVersion
+	if p.crdVersion == "" {
+		// Projects initialized before the CRD version was tracked generate v1beta1 CRDs
+		p.crdVersion = scaffolds.LegacyCRDVersion
+	}
+
 	// TODO: re-evaluate whether y/n input still makes sense. We should probably always
 	// scaffold the resource and controller.
 	reader := bufio.NewReader(os.Stdin)
@@ -149,6 +197,45 @@ func (p *createAPIPlugin) Validate() error {
 		p.doController = util.YesNo(reader)
 	}
 
+	// External resources are defined out of the project, so there is no resource to scaffold
+	if p.externalAPIPath != "" && p.doResource {
+		return errors.New("external-api-path can only be set when not scaffolding the resource")
+	}
+	if p.externalAPIDomain != "" && p.externalAPIPath == "" {
+		return errors.New("external-api-domain can only be set along with external-api-path")
+	}
+
+	if p.finalizer && !p.doController {
+		return errors.New("finalizer can only be set when scaffolding a controller")
+	}
+
+	// Parse the owned resources, which are watched by the controller
+	if len(p.owns) != 0 && !p.doController {
+		return errors.New("owned resources can only be set when scaffolding a controller")
+	}
+	for _, owned := range p.owns {
+		gvk := strings.Split(owned, "/")
+		if len(gvk) != 3 && len(gvk) != 4 {
+			return fmt.Errorf("owned resource must be formatted as group/version/kind[/plural] (was %s)", owned)
+		}
+		ownedResource := &resource.Options{Group: gvk[0], Version: gvk[1], Kind: gvk[2]}
+		if len(gvk) == 4 {
+			ownedResource.Plural = gvk[3]
+		}
+		if err := ownedResource.Validate(); err != nil {
+			return fmt.Errorf("invalid owned resource %s: %v", owned, err)
+		}
+		// The controller already watches its own resource through For
+		if ownedResource.GVK() == p.resource.GVK() {
+			return fmt.Errorf("owned resource %s is the resource reconciled by the controller", owned)
+		}
+		// Only the packages of project and builtin core resources are known, any other type can not be imported
+		if !p.config.HasResource(ownedResource.GVK()) && !ownedResource.IsCoreGroup() {
+			return fmt.Errorf("owned resource %s must be a project resource or a builtin core resource", owned)
+		}
+		p.ownedResources = append(p.ownedResources, ownedResource)
+	}
+
 	// In case we want to scaffold a resource API we need to do some checks
 	if p.doResource {
 		// Check that resource doesn't exist or flag force was set
@@ -186,7 +273,18 @@ func (p *createAPIPlugin) GetScaffolder() (scaffold.Scaffolder, error) {
 
 	// Create the actual resource from the resource options
 	res := p.resource.NewResource(p.config, p.doResource)
-	return scaffolds.NewAPIScaffolder(p.config, string(bp), res, p.doResource, p.doController, plugins), nil
+	if p.externalAPIPath != "" {
+		res.Package = p.externalAPIPath
+		if p.externalAPIDomain != "" {
+			res.Domain = p.resource.Group + "." + p.externalAPIDomain
+		}
+	}
+	owns := make([]*resource.Resource, 0, len(p.ownedResources))
+	for _, ownedResource := range p.ownedResources {
+		owns = append(owns, ownedResource.NewResource(p.config, false))
+	}
+	return scaffolds.NewAPIScaffolder(p.config, string(bp), res, owns, p.finalizer, p.crdVersion,
+		p.doResource, p.doController, p.force, plugins), nil
 }
 
 func (p *createAPIPlugin) PostScaffold() error {
@@ -0,0 +1,116 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v3
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/pflag"
+
+	"sigs.k8s.io/kubebuilder/pkg/model/config"
+	"sigs.k8s.io/kubebuilder/pkg/plugin"
+	"sigs.k8s.io/kubebuilder/pkg/plugin/v3/scaffolds"
+)
+
+var _ = Describe("createAPIPlugin", func() {
+	var (
+		p  *createAPIPlugin
+		c  *config.Config
+		fs *pflag.FlagSet
+	)
+
+	BeforeEach(func() {
+		c = &config.Config{Version: config.Version3Alpha, Domain: "example.com", Repo: "example.com/project"}
+		p = &createAPIPlugin{}
+		p.InjectConfig(c)
+		fs = pflag.NewFlagSet("create api", pflag.ContinueOnError)
+		p.BindFlags(fs)
+	})
+
+	parse := func(args ...string) {
+		base := []string{"--group=crew", "--version=v1", "--kind=Captain", "--resource", "--controller"}
+		Expect(fs.Parse(append(base, args...))).To(Succeed())
+	}
+
+	Context("Validate", func() {
+		It("should use the CRD version stored in the plugin config", func() {
+			Expect(c.EncodePluginConfig(plugin.KeyFor(Plugin{}),
+				pluginConfig{CRDVersion: scaffolds.DefaultCRDVersion})).To(Succeed())
+			parse()
+			Expect(p.Validate()).To(Succeed())
+			Expect(p.crdVersion).To(Equal(scaffolds.DefaultCRDVersion))
+		})
+
+		It("should fall back to the legacy CRD version for projects without plugin config", func() {
+			parse()
+			Expect(p.Validate()).To(Succeed())
+			Expect(p.crdVersion).To(Equal(scaffolds.LegacyCRDVersion))
+		})
+
+		It("should reject a finalizer without a controller", func() {
+			parse("--controller=false", "--finalizer")
+			Expect(p.Validate()).To(MatchError("finalizer can only be set when scaffolding a controller"))
+		})
+
+		It("should reject an external API path when scaffolding the resource", func() {
+			parse("--external-api-path=example.com/external/api/v1")
+			Expect(p.Validate()).To(MatchError("external-api-path can only be set when not scaffolding the resource"))
+		})
+
+		It("should reject an external API domain without an external API path", func() {
+			parse("--resource=false", "--external-api-domain=example.org")
+			Expect(p.Validate()).To(MatchError("external-api-domain can only be set along with external-api-path"))
+		})
+
+		It("should accept an external API path and domain for a controller", func() {
+			parse("--resource=false", "--external-api-path=example.com/external/api/v1", "--external-api-domain=example.org")
+			Expect(p.Validate()).To(Succeed())
+		})
+
+		It("should accept owned project and builtin core resources", func() {
+			c.AddResource(config.GVK{Group: "crew", Version: "v1", Kind: "FirstMate"})
+			parse("--owns=crew/v1/FirstMate", "--owns=apps/v1/Deployment")
+			Expect(p.Validate()).To(Succeed())
+			Expect(p.ownedResources).To(HaveLen(2))
+		})
+
+		It("should reject owning the resource reconciled by the controller", func() {
+			parse("--owns=crew/v1/Captain")
+			Expect(p.Validate()).To(MatchError("owned resource crew/v1/Captain is the resource reconciled by the controller"))
+		})
+
+		It("should use the plural given for an owned resource", func() {
+			parse("--owns=apps/v1/Deployment", "--owns=core/v1/Endpoints/endpoints")
+			Expect(p.Validate()).To(Succeed())
+			Expect(p.ownedResources).To(HaveLen(2))
+			Expect(p.ownedResources[0].Plural).To(BeEmpty())
+			Expect(p.ownedResources[1].Plural).To(Equal("endpoints"))
+		})
+
+		It("should reject badly formatted owned resources", func() {
+			parse("--owns=apps/v1")
+			Expect(p.Validate()).To(MatchError(
+				"owned resource must be formatted as group/version/kind[/plural] (was apps/v1)"))
+		})
+
+		It("should reject owned resources whose package is unknown", func() {
+			parse("--owns=cert-manager/v1/Certificate")
+			Expect(p.Validate()).To(MatchError(
+				"owned resource cert-manager/v1/Certificate must be a project resource or a builtin core resource"))
+		})
+	})
+})
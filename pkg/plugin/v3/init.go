@@ -45,6 +45,7 @@ type initPlugin struct {
 	// flags
 	fetchDeps          bool
 	skipGoVersionCheck bool
+	crdVersion         string
 }
 
 var (
@@ -92,6 +93,11 @@ func (p *initPlugin) BindFlags(fs *pflag.FlagSet) {
 		"defaults to the go package of the current working directory.")
 	fs.StringVar(&p.config.Domain, "domain", "my.domain", "domain for groups")
 	fs.StringVar(&p.config.ProjectName, "project-name", "", "name of this project")
+
+	// manifests args
+	fs.StringVar(&p.crdVersion, "crd-version", scaffolds.DefaultCRDVersion,
+		"apiextensions.k8s.io version of the generated CRDs, may be one of 'v1', 'v1beta1' "+
+			"(use 'v1beta1' for clusters older than Kubernetes 1.16)")
 }
 
 func (p *initPlugin) InjectConfig(c *config.Config) {
@@ -123,6 +129,15 @@ func (p *initPlugin) Validate() error {
 		return fmt.Errorf("project name (%s) is invalid: %v", p.config.ProjectName, err)
 	}
 
+	// Check that the requested CRD version can be scaffolded and store it for later commands
+	if p.crdVersion != scaffolds.DefaultCRDVersion && p.crdVersion != scaffolds.LegacyCRDVersion {
+		return fmt.Errorf("crd-version must be one of '%s', '%s' (was %s)",
+			scaffolds.DefaultCRDVersion, scaffolds.LegacyCRDVersion, p.crdVersion)
+	}
+	if err := p.config.EncodePluginConfig(plugin.KeyFor(Plugin{}), pluginConfig{CRDVersion: p.crdVersion}); err != nil {
+		return err
+	}
+
 	// Try to guess repository if flag is not set.
 	if p.config.Repo == "" {
 		repoPath, err := util.FindCurrentRepo()
@@ -136,7 +151,7 @@ func (p *initPlugin) Validate() error {
 }
 
 func (p *initPlugin) GetScaffolder() (scaffold.Scaffolder, error) {
-	return scaffolds.NewInitScaffolder(p.config, p.license, p.owner), nil
+	return scaffolds.NewInitScaffolder(p.config, p.license, p.owner, p.crdVersion), nil
 }
 
 func (p *initPlugin) PostScaffold() error {
@@ -0,0 +1,68 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v3
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/pflag"
+
+	"sigs.k8s.io/kubebuilder/pkg/model/config"
+	"sigs.k8s.io/kubebuilder/pkg/plugin"
+	"sigs.k8s.io/kubebuilder/pkg/plugin/v3/scaffolds"
+)
+
+var _ = Describe("initPlugin", func() {
+	var (
+		p  *initPlugin
+		fs *pflag.FlagSet
+	)
+
+	BeforeEach(func() {
+		p = &initPlugin{}
+		p.InjectConfig(&config.Config{Version: config.Version3Alpha})
+		fs = pflag.NewFlagSet("init", pflag.ContinueOnError)
+		p.BindFlags(fs)
+	})
+
+	parse := func(args ...string) {
+		base := []string{"--skip-go-version-check", "--project-name=project", "--repo=example.com/project"}
+		Expect(fs.Parse(append(base, args...))).To(Succeed())
+	}
+
+	Context("Validate", func() {
+		DescribeTable("should store the CRD version in the plugin config",
+			func(args []string, crdVersion string) {
+				parse(args...)
+				Expect(p.Validate()).To(Succeed())
+
+				cfg := pluginConfig{}
+				Expect(p.config.DecodePluginConfig(plugin.KeyFor(Plugin{}), &cfg)).To(Succeed())
+				Expect(cfg.CRDVersion).To(Equal(crdVersion))
+			},
+			Entry("by default", nil, scaffolds.DefaultCRDVersion),
+			Entry("for v1", []string{"--crd-version=v1"}, scaffolds.DefaultCRDVersion),
+			Entry("for v1beta1", []string{"--crd-version=v1beta1"}, scaffolds.LegacyCRDVersion),
+		)
+
+		It("should fail for an unsupported CRD version", func() {
+			parse("--crd-version=v2")
+			Expect(p.Validate()).To(MatchError("crd-version must be one of 'v1', 'v1beta1' (was v2)"))
+		})
+	})
+})
@@ -35,6 +35,12 @@ var (
 	_ plugin.CreateWebhookPluginGetter = Plugin{}
 )
 
+// pluginConfig contains the settings of this plugin that are stored in the PROJECT file
+type pluginConfig struct {
+	// CRDVersion is the apiextensions.k8s.io API version of the generated CRDs
+	CRDVersion string `json:"crdVersion,omitempty"`
+}
+
 type Plugin struct {
 	initPlugin
 	createAPIPlugin
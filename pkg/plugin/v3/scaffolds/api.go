@@ -40,12 +40,20 @@ type apiScaffolder struct {
 	config      *config.Config
 	boilerplate string
 	resource    *resource.Resource
+	// owns are the secondary resources owned by the controller
+	owns []*resource.Resource
+	// finalizer indicates whether to scaffold the finalizer handling in the controller
+	finalizer bool
+	// crdVersion is the apiextensions.k8s.io version of the project CRDs
+	crdVersion string
 	// plugins is the list of plugins we should allow to transform our generated scaffolding
 	plugins []model.Plugin
 	// doResource indicates whether to scaffold API Resource or not
 	doResource bool
 	// doController indicates whether to scaffold controller files or not
 	doController bool
+	// force indicates whether to overwrite the existing API and controller files
+	force bool
 }
 
 // NewAPIScaffolder returns a new Scaffolder for API/controller creation operations
@@ -53,16 +61,23 @@ func NewAPIScaffolder(
 	config *config.Config,
 	boilerplate string,
 	res *resource.Resource,
-	doResource, doController bool,
+	owns []*resource.Resource,
+	finalizer bool,
+	crdVersion string,
+	doResource, doController, force bool,
 	plugins []model.Plugin,
 ) scaffold.Scaffolder {
 	return &apiScaffolder{
 		config:       config,
 		boilerplate:  boilerplate,
 		resource:     res,
+		owns:         owns,
+		finalizer:    finalizer,
+		crdVersion:   crdVersion,
 		plugins:      plugins,
 		doResource:   doResource,
 		doController: doController,
+		force:        force,
 	}
 }
 
@@ -87,13 +102,13 @@ func (s *apiScaffolder) scaffold() error {
 
 		if err := machinery.NewScaffold(s.plugins...).Execute(
 			s.newUniverse(),
-			&api.Types{},
+			&api.Types{Force: s.force},
 			&api.Group{},
-			&samples.CRDSample{},
+			&samples.CRDSample{Force: s.force},
 			&rbac.CRDEditorRole{},
 			&rbac.CRDViewerRole{},
-			&crd.EnableWebhookPatch{},
-			&crd.EnableCAInjectionPatch{},
+			&crd.EnableWebhookPatch{CRDVersion: s.crdVersion},
+			&crd.EnableCAInjectionPatch{CRDVersion: s.crdVersion},
 		); err != nil {
 			return fmt.Errorf("error scaffolding APIs: %v", err)
 		}
@@ -101,7 +116,7 @@ func (s *apiScaffolder) scaffold() error {
 		if err := machinery.NewScaffold().Execute(
 			s.newUniverse(),
 			&crd.Kustomization{},
-			&crd.KustomizeConfig{},
+			&crd.KustomizeConfig{CRDVersion: s.crdVersion},
 		); err != nil {
 			return fmt.Errorf("error scaffolding kustomization: %v", err)
 		}
@@ -109,13 +124,26 @@ func (s *apiScaffolder) scaffold() error {
 	}
 
 	if s.doController {
+		// Only project resources scaffolded without an extension pattern have the status type the controller
+		// records the observed generation in
+		updateStatus := s.config.HasResource(s.resource.GVK()) && len(s.plugins) == 0
+
 		if err := machinery.NewScaffold(s.plugins...).Execute(
 			s.newUniverse(),
 			&controller.SuiteTest{},
-			&controller.Controller{},
+			&controller.Controller{Owns: s.owns, Finalizer: s.finalizer, UpdateStatus: updateStatus, Force: s.force},
 		); err != nil {
 			return fmt.Errorf("error scaffolding controller: %v", err)
 		}
+
+		if updateStatus {
+			if err := machinery.NewScaffold().Execute(
+				s.newUniverse(),
+				&controller.ControllerTest{Finalizer: s.finalizer, Force: s.force},
+			); err != nil {
+				return fmt.Errorf("error scaffolding controller test: %v", err)
+			}
+		}
 	}
 
 	if err := machinery.NewScaffold(s.plugins...).Execute(
@@ -45,6 +45,10 @@ const (
 	// todo: update the tag release when the next version of the project be released with this script
 	// ControllerRuntimeEnvTestVersion version to be used to download the envtest setup script
 	ControllerRuntimeEnvTestVersion = "master"
+	// DefaultCRDVersion is the apiextensions.k8s.io version of the CRDs generated for new projects
+	DefaultCRDVersion = "v1"
+	// LegacyCRDVersion is the apiextensions.k8s.io version of the CRDs generated for older clusters
+	LegacyCRDVersion = "v1beta1"
 
 	imageName = "controller:latest"
 )
@@ -56,15 +60,17 @@ type initScaffolder struct {
 	boilerplatePath string
 	license         string
 	owner           string
+	crdVersion      string
 }
 
 // NewInitScaffolder returns a new Scaffolder for project initialization operations
-func NewInitScaffolder(config *config.Config, license, owner string) scaffold.Scaffolder {
+func NewInitScaffolder(config *config.Config, license, owner, crdVersion string) scaffold.Scaffolder {
 	return &initScaffolder{
 		config:          config,
 		boilerplatePath: filepath.Join("hack", "boilerplate.go.txt"),
 		license:         license,
 		owner:           owner,
+		crdVersion:      crdVersion,
 	}
 }
 
@@ -116,6 +122,7 @@ func (s *initScaffolder) scaffold() error {
 			ControllerToolsVersion:          ControllerToolsVersion,
 			KustomizeVersion:                KustomizeVersion,
 			ControllerRuntimeEnvTestVersion: ControllerRuntimeEnvTestVersion,
+			CRDVersion:                      s.crdVersion,
 		},
 		&templates.Dockerfile{},
 		&templates.DockerignoreFile{},
@@ -19,6 +19,9 @@ package api
 import (
 	"fmt"
 	"path/filepath"
+	"strings"
+
+	"github.com/gobuffalo/flect"
 
 	"sigs.k8s.io/kubebuilder/pkg/model/file"
 )
@@ -31,6 +34,12 @@ type Types struct {
 	file.MultiGroupMixin
 	file.BoilerplateMixin
 	file.ResourceMixin
+
+	// ResourceMarkerArgs are the arguments of the +kubebuilder:resource marker, if any is needed
+	ResourceMarkerArgs string
+
+	// Force indicates that the file should be overwritten if it already exists
+	Force bool
 }
 
 // SetTemplateDefaults implements input.Template
@@ -47,7 +56,27 @@ func (f *Types) SetTemplateDefaults() error {
 
 	f.TemplateBody = typesTemplate
 
-	f.IfExistsAction = file.Error
+	if f.Force {
+		f.IfExistsAction = file.Overwrite
+	} else {
+		f.IfExistsAction = file.Error
+	}
+
+	args := make([]string, 0, 4)
+	// controller-gen pluralizes the Kind the same way, so the plural only needs to be set if it differs
+	if f.Resource.Plural != flect.Pluralize(strings.ToLower(f.Resource.Kind)) {
+		args = append(args, "path="+f.Resource.Plural)
+	}
+	if !f.Resource.Namespaced {
+		args = append(args, "scope=Cluster")
+	}
+	if len(f.Resource.ShortNames) != 0 {
+		args = append(args, "shortName="+strings.Join(f.Resource.ShortNames, ";"))
+	}
+	if len(f.Resource.Categories) != 0 {
+		args = append(args, "categories="+strings.Join(f.Resource.Categories, ";"))
+	}
+	f.ResourceMarkerArgs = strings.Join(args, ",")
 
 	return nil
 }
@@ -76,11 +105,18 @@ type {{ .Resource.Kind }}Spec struct {
 type {{ .Resource.Kind }}Status struct {
 	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
 	// Important: Run "make" to regenerate code after modifying this file
+
+	// ObservedGeneration is the most recent generation observed for this {{ .Resource.Kind }}.
+	// It corresponds to the {{ .Resource.Kind }}'s generation, which is updated on mutation by the API Server.
+	// +optional
+	ObservedGeneration int64 ` + "`" + `json:"observedGeneration,omitempty"` + "`" + `
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
-{{ if not .Resource.Namespaced }} // +kubebuilder:resource:scope=Cluster {{ end }}
+{{- if .ResourceMarkerArgs }}
+// +kubebuilder:resource:{{ .ResourceMarkerArgs }}
+{{- end }}
 
 // {{ .Resource.Kind }} is the Schema for the {{ .Resource.Plural }} API
 type {{ .Resource.Kind }} struct {
@@ -21,6 +21,7 @@ import (
 	"path/filepath"
 
 	"sigs.k8s.io/kubebuilder/pkg/model/file"
+	"sigs.k8s.io/kubebuilder/pkg/model/resource"
 )
 
 var _ file.Template = &Controller{}
@@ -30,7 +31,23 @@ type Controller struct {
 	file.TemplateMixin
 	file.MultiGroupMixin
 	file.BoilerplateMixin
+	file.DomainMixin
 	file.ResourceMixin
+
+	// Owns are the secondary resources owned by the controller
+	Owns []*resource.Resource
+	// OwnedImports maps the import aliases of the owned resources to their packages, excluding the resource's own
+	OwnedImports map[string]string
+
+	// Finalizer indicates whether to scaffold the finalizer handling of the resource
+	Finalizer bool
+
+	// UpdateStatus indicates whether to record the observed generation through the status subresource,
+	// which requires the status type scaffolded for project resources
+	UpdateStatus bool
+
+	// Force indicates that the file should be overwritten if it already exists
+	Force bool
 }
 
 // SetTemplateDefaults implements input.Template
@@ -47,7 +64,25 @@ func (f *Controller) SetTemplateDefaults() error {
 
 	f.TemplateBody = controllerTemplate
 
-	f.IfExistsAction = file.Error
+	if f.Force {
+		f.IfExistsAction = file.Overwrite
+	} else {
+		f.IfExistsAction = file.Error
+	}
+
+	// Owned resources sharing a package with the resource or between them only need a single import
+	aliases := map[string]string{f.Resource.ImportAlias: f.Resource.Package}
+	f.OwnedImports = make(map[string]string, len(f.Owns))
+	for _, owned := range f.Owns {
+		if pkg, found := aliases[owned.ImportAlias]; found {
+			if pkg != owned.Package {
+				return fmt.Errorf("import alias %s is used by both %s and %s", owned.ImportAlias, pkg, owned.Package)
+			}
+			continue
+		}
+		aliases[owned.ImportAlias] = owned.Package
+		f.OwnedImports[owned.ImportAlias] = owned.Package
+	}
 
 	return nil
 }
@@ -60,12 +95,26 @@ package controllers
 import (
 	"context"
 	"github.com/go-logr/logr"
+	{{- if or .Finalizer .UpdateStatus }}
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	{{- end }}
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	{{- if .Finalizer }}
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	{{- end }}
 	{{ .Resource.ImportAlias }} "{{ .Resource.Package }}"
+	{{- range $alias, $package := .OwnedImports }}
+	{{ $alias }} "{{ $package }}"
+	{{- end }}
 )
 
+{{ if .Finalizer -}}
+// {{ .Resource.Kind | lower }}Finalizer is the finalizer that lets the controller clean up before a {{ .Resource.Kind }} is deleted
+const {{ .Resource.Kind | lower }}Finalizer = "{{ .Resource.Plural }}.{{ .Resource.Group }}.{{ .Domain }}/finalizer"
+
+{{ end -}}
 // {{ .Resource.Kind }}Reconciler reconciles a {{ .Resource.Kind }} object
 type {{ .Resource.Kind }}Reconciler struct {
 	client.Client
@@ -75,19 +124,91 @@ type {{ .Resource.Kind }}Reconciler struct {
 
 // +kubebuilder:rbac:groups={{ .Resource.Domain }},resources={{ .Resource.Plural }},verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups={{ .Resource.Domain }},resources={{ .Resource.Plural }}/status,verbs=get;update;patch
+{{- range .Owns }}
+// +kubebuilder:rbac:groups={{ .Domain }},resources={{ .Plural }},verbs=get;list;watch;create;update;patch;delete
+{{- end }}
 
 func (r *{{ .Resource.Kind }}Reconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	{{- if or .Finalizer .UpdateStatus }}
+	ctx := context.Background()
+	{{- else }}
 	_ = context.Background()
+	{{- end }}
 	_ = r.Log.WithValues("{{ .Resource.Kind | lower }}", req.NamespacedName)
+	{{- if or .Finalizer .UpdateStatus }}
+
+	obj := &{{ .Resource.ImportAlias }}.{{ .Resource.Kind }}{}
+	if err := r.Get(ctx, req.NamespacedName, obj); err != nil {
+		// Objects deleted since the request was queued are not found and need no further reconciliation
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	{{- end }}
+	{{- if .Finalizer }}
+
+	if obj.GetDeletionTimestamp().IsZero() {
+		// The object is not being deleted, so make sure it has our finalizer
+		if !controllerutil.ContainsFinalizer(obj, {{ .Resource.Kind | lower }}Finalizer) {
+			controllerutil.AddFinalizer(obj, {{ .Resource.Kind | lower }}Finalizer)
+			if err := r.Update(ctx, obj); err != nil {
+				return r.requeueOnConflict(err)
+			}
+		}
+	} else {
+		// The object is being deleted, so run the cleanup logic before removing our finalizer
+		if controllerutil.ContainsFinalizer(obj, {{ .Resource.Kind | lower }}Finalizer) {
+			// your cleanup logic here, which must be idempotent as it may run more than once
+
+			controllerutil.RemoveFinalizer(obj, {{ .Resource.Kind | lower }}Finalizer)
+			if err := r.Update(ctx, obj); err != nil {
+				return r.requeueOnConflict(err)
+			}
+		}
+
+		return ctrl.Result{}, nil
+	}
+	{{- end }}
 
 	// your logic here
+	{{- if .Owns }}
+
+	// Set the {{ .Resource.Kind }} as the controller owner of the objects it creates, so that they are
+	// garbage collected with it and their changes trigger its reconciliation:
+	// if err := ctrl.SetControllerReference(obj, owned, r.Scheme); err != nil {
+	//     return ctrl.Result{}, err
+	// }
+	{{- end }}
+	{{- if .UpdateStatus }}
+
+	// Record the reconciled generation through the status client, as the status subresource
+	// ignores status changes made through the main resource endpoint
+	if obj.Status.ObservedGeneration != obj.GetGeneration() {
+		obj.Status.ObservedGeneration = obj.GetGeneration()
+		if err := r.Status().Update(ctx, obj); err != nil {
+			return r.requeueOnConflict(err)
+		}
+	}
+	{{- end }}
 
 	return ctrl.Result{}, nil
 }
 
+{{ if or .Finalizer .UpdateStatus -}}
+// requeueOnConflict retries the reconciliation with the latest version of the object when it was modified
+// concurrently, as updating an outdated copy of the object fails with a conflict
+func (r *{{ .Resource.Kind }}Reconciler) requeueOnConflict(err error) (ctrl.Result, error) {
+	if apierrors.IsConflict(err) {
+		return ctrl.Result{Requeue: true}, nil
+	}
+	return ctrl.Result{}, err
+}
+
+{{ end -}}
 func (r *{{ .Resource.Kind }}Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&{{ .Resource.ImportAlias }}.{{ .Resource.Kind }}{}).
+		{{- range .Owns }}
+		Owns(&{{ .ImportAlias }}.{{ .Kind }}{}).
+		{{- end }}
 		Complete(r)
 }
 `
@@ -0,0 +1,149 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"path/filepath"
+
+	"sigs.k8s.io/kubebuilder/pkg/model/file"
+)
+
+var _ file.Template = &ControllerTest{}
+
+// ControllerTest scaffolds the envtest specs of a Controller for a Resource
+type ControllerTest struct {
+	file.TemplateMixin
+	file.MultiGroupMixin
+	file.BoilerplateMixin
+	file.ResourceMixin
+
+	// Finalizer indicates whether to scaffold the test of the finalizer handling of the resource
+	Finalizer bool
+
+	// Force indicates that the file should be overwritten if it already exists
+	Force bool
+}
+
+// SetTemplateDefaults implements file.Template
+func (f *ControllerTest) SetTemplateDefaults() error {
+	if f.Path == "" {
+		if f.MultiGroup {
+			f.Path = filepath.Join("controllers", "%[group]", "%[kind]_controller_test.go")
+		} else {
+			f.Path = filepath.Join("controllers", "%[kind]_controller_test.go")
+		}
+	}
+	f.Path = f.Resource.Replacer().Replace(f.Path)
+
+	f.TemplateBody = controllerTestTemplate
+
+	if f.Force {
+		f.IfExistsAction = file.Overwrite
+	} else {
+		f.IfExistsAction = file.Error
+	}
+
+	return nil
+}
+
+//nolint:lll
+const controllerTestTemplate = `{{ .Boilerplate }}
+
+package controllers
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	{{- if .Finalizer }}
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	{{- end }}
+
+	{{ .Resource.ImportAlias }} "{{ .Resource.Package }}"
+)
+
+var _ = Describe("{{ .Resource.Kind }} controller", func() {
+	var (
+		ctx        = context.Background()
+		key        = types.NamespacedName{Name: "test-{{ .Resource.Kind | lower }}"{{ if .Resource.Namespaced }}, Namespace: "default"{{ end }}}
+		reconciler *{{ .Resource.Kind }}Reconciler
+		obj        *{{ .Resource.ImportAlias }}.{{ .Resource.Kind }}
+	)
+
+	BeforeEach(func() {
+		reconciler = &{{ .Resource.Kind }}Reconciler{
+			Client: k8sClient,
+			Log:    ctrl.Log.WithName("controllers").WithName("{{ .Resource.Kind }}"),
+			Scheme: scheme.Scheme,
+		}
+
+		obj = &{{ .Resource.ImportAlias }}.{{ .Resource.Kind }}{ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace}}
+		Expect(k8sClient.Create(ctx, obj)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		// Clear the finalizers, as no controller is running to remove them when the object is deleted
+		latest := &{{ .Resource.ImportAlias }}.{{ .Resource.Kind }}{}
+		err := k8sClient.Get(ctx, key, latest)
+		if apierrors.IsNotFound(err) {
+			return
+		}
+		Expect(err).NotTo(HaveOccurred())
+		latest.SetFinalizers(nil)
+		Expect(k8sClient.Update(ctx, latest)).To(Succeed())
+		Expect(client.IgnoreNotFound(k8sClient.Delete(ctx, latest))).To(Succeed())
+	})
+
+	It("should record the observed generation through the status client", func() {
+		_, err := reconciler.Reconcile(ctrl.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(k8sClient.Get(ctx, key, obj)).To(Succeed())
+		Expect(obj.Status.ObservedGeneration).To(Equal(obj.GetGeneration()))
+	})
+
+	It("should ignore status changes made through the main resource endpoint", func() {
+		obj.Status.ObservedGeneration = 42
+		Expect(k8sClient.Update(ctx, obj)).To(Succeed())
+
+		Expect(k8sClient.Get(ctx, key, obj)).To(Succeed())
+		Expect(obj.Status.ObservedGeneration).To(BeZero())
+	})
+	{{- if .Finalizer }}
+
+	It("should remove its finalizer once the {{ .Resource.Kind }} is deleted", func() {
+		_, err := reconciler.Reconcile(ctrl.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(k8sClient.Get(ctx, key, obj)).To(Succeed())
+		Expect(controllerutil.ContainsFinalizer(obj, {{ .Resource.Kind | lower }}Finalizer)).To(BeTrue())
+
+		// The API server only marks the object as deleted until the reconciler removes its finalizer
+		Expect(k8sClient.Delete(ctx, obj)).To(Succeed())
+		_, err = reconciler.Reconcile(ctrl.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(apierrors.IsNotFound(k8sClient.Get(ctx, key, obj))).To(BeTrue())
+	})
+	{{- end }}
+})
+`
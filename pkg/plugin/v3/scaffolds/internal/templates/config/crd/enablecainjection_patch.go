@@ -28,6 +28,9 @@ var _ file.Template = &EnableCAInjectionPatch{}
 type EnableCAInjectionPatch struct {
 	file.TemplateMixin
 	file.ResourceMixin
+
+	// CRDVersion is the apiextensions.k8s.io version of the patched CRD
+	CRDVersion string
 }
 
 // SetTemplateDefaults implements input.Template
@@ -43,8 +46,10 @@ func (f *EnableCAInjectionPatch) SetTemplateDefaults() error {
 }
 
 const enableCAInjectionPatchTemplate = `# The following patch adds a directive for certmanager to inject CA into the CRD
+{{- if eq .CRDVersion "v1beta1" }}
 # CRD conversion requires k8s 1.13 or later.
-apiVersion: apiextensions.k8s.io/v1beta1
+{{- end }}
+apiVersion: apiextensions.k8s.io/{{ .CRDVersion }}
 kind: CustomResourceDefinition
 metadata:
   annotations:
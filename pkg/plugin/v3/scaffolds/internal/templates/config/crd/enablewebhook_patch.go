@@ -28,6 +28,9 @@ var _ file.Template = &EnableWebhookPatch{}
 type EnableWebhookPatch struct {
 	file.TemplateMixin
 	file.ResourceMixin
+
+	// CRDVersion is the apiextensions.k8s.io version of the patched CRD
+	CRDVersion string
 }
 
 // SetTemplateDefaults implements input.Template
@@ -37,12 +40,34 @@ func (f *EnableWebhookPatch) SetTemplateDefaults() error {
 	}
 	f.Path = f.Resource.Replacer().Replace(f.Path)
 
-	f.TemplateBody = enableWebhookPatchTemplate
+	if f.CRDVersion == "v1beta1" {
+		f.TemplateBody = enableWebhookPatchV1beta1Template
+	} else {
+		f.TemplateBody = enableWebhookPatchTemplate
+	}
 
 	return nil
 }
 
 const enableWebhookPatchTemplate = `# The following patch enables conversion webhook for CRD
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: {{ .Resource.Plural }}.{{ .Resource.Domain }}
+spec:
+  conversion:
+    strategy: Webhook
+    webhook:
+      clientConfig:
+        service:
+          namespace: system
+          name: webhook-service
+          path: /convert
+      conversionReviewVersions:
+      - v1beta1
+`
+
+const enableWebhookPatchV1beta1Template = `# The following patch enables conversion webhook for CRD
 # CRD conversion requires k8s 1.13 or later.
 apiVersion: apiextensions.k8s.io/v1beta1
 kind: CustomResourceDefinition
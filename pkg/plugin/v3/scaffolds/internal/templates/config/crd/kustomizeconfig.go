@@ -27,6 +27,9 @@ var _ file.Template = &KustomizeConfig{}
 // KustomizeConfig scaffolds the kustomizeconfig file in crd folder.
 type KustomizeConfig struct {
 	file.TemplateMixin
+
+	// CRDVersion is the apiextensions.k8s.io version of the project CRDs
+	CRDVersion string
 }
 
 // SetTemplateDefaults implements input.Template
@@ -48,12 +51,20 @@ nameReference:
   fieldSpecs:
   - kind: CustomResourceDefinition
     group: apiextensions.k8s.io
+{{- if eq .CRDVersion "v1beta1" }}
     path: spec/conversion/webhookClientConfig/service/name
+{{- else }}
+    path: spec/conversion/webhook/clientConfig/service/name
+{{- end }}
 
 namespace:
 - kind: CustomResourceDefinition
   group: apiextensions.k8s.io
+{{- if eq .CRDVersion "v1beta1" }}
   path: spec/conversion/webhookClientConfig/service/namespace
+{{- else }}
+  path: spec/conversion/webhook/clientConfig/service/namespace
+{{- end }}
   create: false
 
 varReference:
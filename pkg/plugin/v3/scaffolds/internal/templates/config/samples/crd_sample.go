@@ -28,6 +28,9 @@ var _ file.Template = &CRDSample{}
 type CRDSample struct {
 	file.TemplateMixin
 	file.ResourceMixin
+
+	// Force indicates that the file should be overwritten if it already exists
+	Force bool
 }
 
 // SetTemplateDefaults implements input.Template
@@ -37,7 +40,11 @@ func (f *CRDSample) SetTemplateDefaults() error {
 	}
 	f.Path = f.Resource.Replacer().Replace(f.Path)
 
-	f.IfExistsAction = file.Error
+	if f.Force {
+		f.IfExistsAction = file.Overwrite
+	} else {
+		f.IfExistsAction = file.Error
+	}
 
 	f.TemplateBody = crdSampleTemplate
 
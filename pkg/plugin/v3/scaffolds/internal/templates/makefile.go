@@ -36,6 +36,8 @@ type Makefile struct {
 	KustomizeVersion string
 	// ControllerRuntimeEnvTestVersion version to be used to download the envtest setup script
 	ControllerRuntimeEnvTestVersion string
+	// CRDVersion is the apiextensions.k8s.io version of the generated CRDs
+	CRDVersion string
 }
 
 // SetTemplateDefaults implements input.Template
@@ -59,8 +61,13 @@ func (f *Makefile) SetTemplateDefaults() error {
 const makefileTemplate = `
 # Image URL to use all building/pushing image targets
 IMG ?= {{ .Image }}
+{{- if eq .CRDVersion "v1beta1" }}
 # Produce CRDs that work back to Kubernetes 1.11 (no version conversion)
 CRD_OPTIONS ?= "crd:trivialVersions=true"
+{{- else }}
+# Produce apiextensions.k8s.io/v1 CRDs (requires Kubernetes 1.16 or later)
+CRD_OPTIONS ?= "crd:crdVersions={{ .CRDVersion }}"
+{{- end }}
 
 # Get the currently used golang install path (in GOPATH/bin, unless GOBIN is set)
 ifeq (,$(shell go env GOBIN))
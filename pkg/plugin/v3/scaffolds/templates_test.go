@@ -0,0 +1,111 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaffolds
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	"sigs.k8s.io/kubebuilder/pkg/model/config"
+	"sigs.k8s.io/kubebuilder/pkg/model/file"
+	"sigs.k8s.io/kubebuilder/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/pkg/plugin/v3/scaffolds/internal/templates/config/api"
+	"sigs.k8s.io/kubebuilder/pkg/plugin/v3/scaffolds/internal/templates/config/controller"
+	"sigs.k8s.io/kubebuilder/pkg/plugin/v3/scaffolds/internal/templates/config/samples"
+)
+
+var _ = Describe("Templates", func() {
+	var (
+		cfg     *config.Config
+		captain *resource.Resource
+	)
+
+	BeforeEach(func() {
+		cfg = &config.Config{Version: config.Version3Alpha, Domain: "testproject.org", Repo: "example.com/project"}
+		captain = (&resource.Options{Group: "crew", Version: "v1", Kind: "Captain"}).NewResource(cfg, true)
+	})
+
+	newResource := func(group, version, kind string) *resource.Resource {
+		return (&resource.Options{Group: group, Version: version, Kind: kind}).NewResource(cfg, false)
+	}
+
+	DescribeTable("files overwritten by create api --force",
+		func(f interface {
+			file.Template
+			file.HasResource
+		}, expected file.IfExistsAction) {
+			f.InjectResource(captain)
+			Expect(f.SetTemplateDefaults()).To(Succeed())
+			Expect(f.GetIfExistsAction()).To(Equal(expected))
+		},
+		Entry("should not overwrite the types without --force", &api.Types{}, file.Error),
+		Entry("should overwrite the types with --force", &api.Types{Force: true}, file.Overwrite),
+		Entry("should not overwrite the sample without --force", &samples.CRDSample{}, file.Error),
+		Entry("should overwrite the sample with --force", &samples.CRDSample{Force: true}, file.Overwrite),
+		Entry("should not overwrite the controller without --force", &controller.Controller{}, file.Error),
+		Entry("should overwrite the controller with --force", &controller.Controller{Force: true}, file.Overwrite),
+		Entry("should not overwrite the controller test without --force", &controller.ControllerTest{}, file.Error),
+		Entry("should overwrite the controller test with --force", &controller.ControllerTest{Force: true}, file.Overwrite),
+	)
+
+	DescribeTable("Types resource marker arguments",
+		func(options *resource.Options, expected string) {
+			f := &api.Types{}
+			f.Resource = options.NewResource(cfg, true)
+			Expect(f.SetTemplateDefaults()).To(Succeed())
+			Expect(f.ResourceMarkerArgs).To(Equal(expected))
+			Expect(f.GetBody()).To(ContainSubstring("// +kubebuilder:resource:{{ .ResourceMarkerArgs }}"))
+		},
+		Entry("should be empty for a namespaced resource with the defaults",
+			&resource.Options{Group: "crew", Version: "v1", Kind: "Captain", Namespaced: true},
+			""),
+		Entry("should combine the path, scope, short names and categories",
+			&resource.Options{Group: "crew", Version: "v1", Kind: "Chassis", Plural: "chassises",
+				ShortNames: []string{"a", "b"}, Categories: []string{"c"}},
+			"path=chassises,scope=Cluster,shortName=a;b,categories=c"),
+	)
+
+	Context("Controller", func() {
+		It("should import each package of the owned resources once", func() {
+			cfg.AddResource(config.GVK{Group: "crew", Version: "v1", Kind: "FirstMate"})
+			f := &controller.Controller{Owns: []*resource.Resource{
+				newResource("crew", "v1", "FirstMate"),
+				newResource("apps", "v1", "Deployment"),
+				newResource("apps", "v1", "StatefulSet"),
+				newResource("core", "v1", "ConfigMap"),
+			}}
+			f.Resource = captain
+			Expect(f.SetTemplateDefaults()).To(Succeed())
+			Expect(f.OwnedImports).To(Equal(map[string]string{
+				"appsv1": "k8s.io/api/apps/v1",
+				"corev1": "k8s.io/api/core/v1",
+			}))
+		})
+
+		It("should fail if two owned packages share an import alias", func() {
+			cfg.AddResource(config.GVK{Group: "apps", Version: "v1", Kind: "Fleet"})
+			f := &controller.Controller{Owns: []*resource.Resource{
+				newResource("apps", "v1", "Fleet"),
+				newResource("apps", "v1", "Deployment"),
+			}}
+			f.Resource = captain
+			Expect(f.SetTemplateDefaults()).To(MatchError(
+				"import alias appsv1 is used by both example.com/project/api/v1 and k8s.io/api/apps/v1"))
+		})
+	})
+})
@@ -96,6 +96,12 @@ func (p *createWebhookPlugin) Validate() error {
 			" --programmatic-validation and --conversion to be true", p.commandName)
 	}
 
+	// Check that the resource was previously created, as the webhook is scaffolded next to its type
+	if !p.config.HasResource(p.resource.GVK()) {
+		return fmt.Errorf("%s create webhook requires the API resource %s/%s/%s, created with %s create api first",
+			p.commandName, p.resource.Group, p.resource.Version, p.resource.Kind, p.commandName)
+	}
+
 	return nil
 }
 
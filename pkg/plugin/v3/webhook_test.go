@@ -0,0 +1,57 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v3
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/pflag"
+
+	"sigs.k8s.io/kubebuilder/pkg/model/config"
+	"sigs.k8s.io/kubebuilder/pkg/plugin"
+)
+
+var _ = Describe("createWebhookPlugin", func() {
+	var (
+		p  *createWebhookPlugin
+		c  *config.Config
+		fs *pflag.FlagSet
+	)
+
+	BeforeEach(func() {
+		c = &config.Config{Version: config.Version3Alpha, Domain: "example.com", Repo: "example.com/project"}
+		p = &createWebhookPlugin{}
+		p.UpdateContext(&plugin.Context{CommandName: "kubebuilder"})
+		p.InjectConfig(c)
+		fs = pflag.NewFlagSet("create webhook", pflag.ContinueOnError)
+		p.BindFlags(fs)
+	})
+
+	Context("Validate", func() {
+		It("should accept a tracked resource", func() {
+			c.AddResource(config.GVK{Group: "crew", Version: "v1", Kind: "Captain"})
+			Expect(fs.Parse([]string{"--group=crew", "--version=v1", "--kind=Captain", "--defaulting"})).To(Succeed())
+			Expect(p.Validate()).To(Succeed())
+		})
+
+		It("should reject a resource that was not created with create api", func() {
+			Expect(fs.Parse([]string{"--group=crew", "--version=v1", "--kind=Captain", "--defaulting"})).To(Succeed())
+			Expect(p.Validate()).To(MatchError("kubebuilder create webhook requires the API resource crew/v1/Captain, " +
+				"created with kubebuilder create api first"))
+		})
+	})
+})
@@ -151,5 +151,6 @@ GO111MODULE=on test_project project-v2-addon 2
 GO111MODULE=on test_project project-v3 3-alpha
 GO111MODULE=on test_project project-v3-multigroup 3-alpha
 GO111MODULE=on test_project project-v3-addon 3-alpha
+GO111MODULE=on test_project project-v3-crd-v1beta1 3-alpha
 
 exit $rc
@@ -0,0 +1,78 @@
+/*
+Copyright 2020 The Kubernetes authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	crewv1 "sigs.k8s.io/kubebuilder/testdata/project-v3-crd-v1beta1/api/v1"
+)
+
+// CaptainReconciler reconciles a Captain object
+type CaptainReconciler struct {
+	client.Client
+	Log    logr.Logger
+	Scheme *runtime.Scheme
+}
+
+// +kubebuilder:rbac:groups=crew.testproject.org,resources=captains,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=crew.testproject.org,resources=captains/status,verbs=get;update;patch
+
+func (r *CaptainReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	ctx := context.Background()
+	_ = r.Log.WithValues("captain", req.NamespacedName)
+
+	obj := &crewv1.Captain{}
+	if err := r.Get(ctx, req.NamespacedName, obj); err != nil {
+		// Objects deleted since the request was queued are not found and need no further reconciliation
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	// your logic here
+
+	// Record the reconciled generation through the status client, as the status subresource
+	// ignores status changes made through the main resource endpoint
+	if obj.Status.ObservedGeneration != obj.GetGeneration() {
+		obj.Status.ObservedGeneration = obj.GetGeneration()
+		if err := r.Status().Update(ctx, obj); err != nil {
+			return r.requeueOnConflict(err)
+		}
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// requeueOnConflict retries the reconciliation with the latest version of the object when it was modified
+// concurrently, as updating an outdated copy of the object fails with a conflict
+func (r *CaptainReconciler) requeueOnConflict(err error) (ctrl.Result, error) {
+	if apierrors.IsConflict(err) {
+		return ctrl.Result{Requeue: true}, nil
+	}
+	return ctrl.Result{}, err
+}
+
+func (r *CaptainReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&crewv1.Captain{}).
+		Complete(r)
+}
@@ -36,6 +36,11 @@ type HealthCheckPolicySpec struct {
 type HealthCheckPolicyStatus struct {
 	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
 	// Important: Run "make" to regenerate code after modifying this file
+
+	// ObservedGeneration is the most recent generation observed for this HealthCheckPolicy.
+	// It corresponds to the HealthCheckPolicy's generation, which is updated on mutation by the API Server.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 }
 
 // +kubebuilder:object:root=true
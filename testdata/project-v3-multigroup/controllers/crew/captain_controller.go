@@ -20,6 +20,7 @@ import (
 	"context"
 
 	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -38,14 +39,38 @@ type CaptainReconciler struct {
 // +kubebuilder:rbac:groups=crew.testproject.org,resources=captains/status,verbs=get;update;patch
 
 func (r *CaptainReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
-	_ = context.Background()
+	ctx := context.Background()
 	_ = r.Log.WithValues("captain", req.NamespacedName)
 
+	obj := &crewv1.Captain{}
+	if err := r.Get(ctx, req.NamespacedName, obj); err != nil {
+		// Objects deleted since the request was queued are not found and need no further reconciliation
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
 	// your logic here
 
+	// Record the reconciled generation through the status client, as the status subresource
+	// ignores status changes made through the main resource endpoint
+	if obj.Status.ObservedGeneration != obj.GetGeneration() {
+		obj.Status.ObservedGeneration = obj.GetGeneration()
+		if err := r.Status().Update(ctx, obj); err != nil {
+			return r.requeueOnConflict(err)
+		}
+	}
+
 	return ctrl.Result{}, nil
 }
 
+// requeueOnConflict retries the reconciliation with the latest version of the object when it was modified
+// concurrently, as updating an outdated copy of the object fails with a conflict
+func (r *CaptainReconciler) requeueOnConflict(err error) (ctrl.Result, error) {
+	if apierrors.IsConflict(err) {
+		return ctrl.Result{Requeue: true}, nil
+	}
+	return ctrl.Result{}, err
+}
+
 func (r *CaptainReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&crewv1.Captain{}).
@@ -0,0 +1,81 @@
+/*
+Copyright 2020 The Kubernetes authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	crewv1 "sigs.k8s.io/kubebuilder/testdata/project-v3-multigroup/apis/crew/v1"
+)
+
+var _ = Describe("Captain controller", func() {
+	var (
+		ctx        = context.Background()
+		key        = types.NamespacedName{Name: "test-captain", Namespace: "default"}
+		reconciler *CaptainReconciler
+		obj        *crewv1.Captain
+	)
+
+	BeforeEach(func() {
+		reconciler = &CaptainReconciler{
+			Client: k8sClient,
+			Log:    ctrl.Log.WithName("controllers").WithName("Captain"),
+			Scheme: scheme.Scheme,
+		}
+
+		obj = &crewv1.Captain{ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace}}
+		Expect(k8sClient.Create(ctx, obj)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		// Clear the finalizers, as no controller is running to remove them when the object is deleted
+		latest := &crewv1.Captain{}
+		err := k8sClient.Get(ctx, key, latest)
+		if apierrors.IsNotFound(err) {
+			return
+		}
+		Expect(err).NotTo(HaveOccurred())
+		latest.SetFinalizers(nil)
+		Expect(k8sClient.Update(ctx, latest)).To(Succeed())
+		Expect(client.IgnoreNotFound(k8sClient.Delete(ctx, latest))).To(Succeed())
+	})
+
+	It("should record the observed generation through the status client", func() {
+		_, err := reconciler.Reconcile(ctrl.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(k8sClient.Get(ctx, key, obj)).To(Succeed())
+		Expect(obj.Status.ObservedGeneration).To(Equal(obj.GetGeneration()))
+	})
+
+	It("should ignore status changes made through the main resource endpoint", func() {
+		obj.Status.ObservedGeneration = 42
+		Expect(k8sClient.Update(ctx, obj)).To(Succeed())
+
+		Expect(k8sClient.Get(ctx, key, obj)).To(Succeed())
+		Expect(obj.Status.ObservedGeneration).To(BeZero())
+	})
+})
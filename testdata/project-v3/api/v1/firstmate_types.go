@@ -36,10 +36,16 @@ type FirstMateSpec struct {
 type FirstMateStatus struct {
 	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
 	// Important: Run "make" to regenerate code after modifying this file
+
+	// ObservedGeneration is the most recent generation observed for this FirstMate.
+	// It corresponds to the FirstMate's generation, which is updated on mutation by the API Server.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=fm;mate,categories=crew
 
 // FirstMate is the Schema for the firstmates API
 type FirstMate struct {
@@ -20,13 +20,18 @@ import (
 	"context"
 
 	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	crewv1 "sigs.k8s.io/kubebuilder/testdata/project-v3/api/v1"
 )
 
+// admiralFinalizer is the finalizer that lets the controller clean up before a Admiral is deleted
+const admiralFinalizer = "admirals.crew.testproject.org/finalizer"
+
 // AdmiralReconciler reconciles a Admiral object
 type AdmiralReconciler struct {
 	client.Client
@@ -38,14 +43,60 @@ type AdmiralReconciler struct {
 // +kubebuilder:rbac:groups=crew.testproject.org,resources=admirals/status,verbs=get;update;patch
 
 func (r *AdmiralReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
-	_ = context.Background()
+	ctx := context.Background()
 	_ = r.Log.WithValues("admiral", req.NamespacedName)
 
+	obj := &crewv1.Admiral{}
+	if err := r.Get(ctx, req.NamespacedName, obj); err != nil {
+		// Objects deleted since the request was queued are not found and need no further reconciliation
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if obj.GetDeletionTimestamp().IsZero() {
+		// The object is not being deleted, so make sure it has our finalizer
+		if !controllerutil.ContainsFinalizer(obj, admiralFinalizer) {
+			controllerutil.AddFinalizer(obj, admiralFinalizer)
+			if err := r.Update(ctx, obj); err != nil {
+				return r.requeueOnConflict(err)
+			}
+		}
+	} else {
+		// The object is being deleted, so run the cleanup logic before removing our finalizer
+		if controllerutil.ContainsFinalizer(obj, admiralFinalizer) {
+			// your cleanup logic here, which must be idempotent as it may run more than once
+
+			controllerutil.RemoveFinalizer(obj, admiralFinalizer)
+			if err := r.Update(ctx, obj); err != nil {
+				return r.requeueOnConflict(err)
+			}
+		}
+
+		return ctrl.Result{}, nil
+	}
+
 	// your logic here
 
+	// Record the reconciled generation through the status client, as the status subresource
+	// ignores status changes made through the main resource endpoint
+	if obj.Status.ObservedGeneration != obj.GetGeneration() {
+		obj.Status.ObservedGeneration = obj.GetGeneration()
+		if err := r.Status().Update(ctx, obj); err != nil {
+			return r.requeueOnConflict(err)
+		}
+	}
+
 	return ctrl.Result{}, nil
 }
 
+// requeueOnConflict retries the reconciliation with the latest version of the object when it was modified
+// concurrently, as updating an outdated copy of the object fails with a conflict
+func (r *AdmiralReconciler) requeueOnConflict(err error) (ctrl.Result, error) {
+	if apierrors.IsConflict(err) {
+		return ctrl.Result{Requeue: true}, nil
+	}
+	return ctrl.Result{}, err
+}
+
 func (r *AdmiralReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&crewv1.Admiral{}).